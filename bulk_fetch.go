@@ -0,0 +1,149 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import (
+	"context"
+	"sync"
+)
+
+// ShowWalker fans out concurrent season and episode fetches for a show,
+// sharing the Client's caching, retry and rate-limit policy. Build one
+// with Client.NewShowWalker and configure it with
+// WithConcurrency/WithAppend before calling Walk.
+type ShowWalker struct {
+	client           *Client
+	showID           int
+	concurrency      int
+	appendToResponse []string
+}
+
+// NewShowWalker creates a ShowWalker for showID, defaulting to a
+// concurrency of 1 (no fan-out) until WithConcurrency is called.
+func (c *Client) NewShowWalker(showID int) *ShowWalker {
+	return &ShowWalker{client: c, showID: showID, concurrency: 1}
+}
+
+// WithConcurrency sets the maximum number of season and episode requests
+// in flight at once. Values less than 1 are treated as 1.
+func (w *ShowWalker) WithConcurrency(n int) *ShowWalker {
+	if n < 1 {
+		n = 1
+	}
+	w.concurrency = n
+	return w
+}
+
+// WithAppend requests the given sub-payloads (e.g. "credits", "images")
+// be embedded in each season's response via append_to_response.
+func (w *ShowWalker) WithAppend(parts ...string) *ShowWalker {
+	w.appendToResponse = append(w.appendToResponse, parts...)
+	return w
+}
+
+// Walk fetches the show's details, then fans out a bounded worker pool of
+// at most WithConcurrency concurrent requests across both season and
+// episode detail fetches, calling fn once per season once all of that
+// season's episodes have arrived.
+//
+// Walk stops launching new fetches as soon as ctx is cancelled or fn
+// returns an error, and returns the first error encountered.
+func (w *ShowWalker) Walk(ctx context.Context, fn func(season *TVSeasonDetails, episodes []*TVEpisodeDetails) error) error {
+	show, err := w.client.GetTVDetailsWithContext(ctx, w.showID, nil)
+	if err != nil {
+		return err
+	}
+
+	var opts []RequestOption
+	if len(w.appendToResponse) > 0 {
+		opts = append(opts, WithAppendToResponse(w.appendToResponse...))
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// sem bounds the total number of concurrent requests in flight, across
+	// both season and episode fetches, to at most w.concurrency.
+	sem := make(chan struct{}, w.concurrency)
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	acquire := func() bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case sem <- struct{}{}:
+			return true
+		}
+	}
+
+	for _, season := range show.Seasons {
+		season := season
+
+		if !acquire() {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			details, err := w.client.GetTVSeasonDetailsWithContext(ctx, w.showID, season.SeasonNumber, nil, opts...)
+			// Release this season's slot as soon as its own fetch completes,
+			// before fanning its episodes out into the same pool. Holding
+			// the slot across the episode fan-out would let in-flight season
+			// goroutines fill every slot and deadlock, since none could ever
+			// acquire a slot for its own episodes.
+			<-sem
+			if err != nil {
+				fail(err)
+				return
+			}
+
+			episodes := make([]*TVEpisodeDetails, len(details.Episodes))
+			var ewg sync.WaitGroup
+			for i, ep := range details.Episodes {
+				i, ep := i, ep
+
+				if !acquire() {
+					break
+				}
+				ewg.Add(1)
+				go func() {
+					defer ewg.Done()
+					defer func() { <-sem }()
+
+					episode, err := w.client.GetTVEpisodeDetailsWithContext(ctx, w.showID, season.SeasonNumber, ep.EpisodeNumber, nil, opts...)
+					if err != nil {
+						fail(err)
+						return
+					}
+					episodes[i] = episode
+				}()
+			}
+			ewg.Wait()
+
+			// ctx is cancelled either by an episode fetch failing above or
+			// by some other season/fn erroring concurrently; either way
+			// episodes may have unfilled holes, so don't hand fn a partial
+			// result.
+			if ctx.Err() != nil {
+				return
+			}
+
+			if err := fn(details, episodes); err != nil {
+				fail(err)
+			}
+		}()
+	}
+
+	wg.Wait()
+	return firstErr
+}
@@ -0,0 +1,131 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestShowWalkerWalkFansOutEpisodesAtDefaultConcurrency drives Walk
+// end-to-end against an httptest server with the default concurrency of
+// 1 (set by NewShowWalker). Season and episode fetches used to share a
+// single semaphore slot per season, so a season goroutine holding its
+// slot across its own episode fan-out could never acquire a slot for
+// those episodes, deadlocking Walk on the very first season that had
+// one. This test fails by hanging (rather than with an assertion) if
+// that regresses.
+func TestShowWalkerWalkFansOutEpisodesAtDefaultConcurrency(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/3/tv/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"seasons":[{"season_number":1},{"season_number":2}]}`)
+	})
+	mux.HandleFunc("/3/tv/1/season/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":10,"season_number":1,"episodes":[{"episode_number":1},{"episode_number":2}]}`)
+	})
+	mux.HandleFunc("/3/tv/1/season/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":20,"season_number":2,"episodes":[{"episode_number":1}]}`)
+	})
+	mux.HandleFunc("/3/tv/1/season/1/episode/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":101,"season_number":1,"episode_number":1}`)
+	})
+	mux.HandleFunc("/3/tv/1/season/1/episode/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":102,"season_number":1,"episode_number":2}`)
+	})
+	mux.HandleFunc("/3/tv/1/season/2/episode/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":201,"season_number":2,"episode_number":1}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	originalBaseURL := baseURL
+	baseURL = server.URL + "/3"
+	defer func() { baseURL = originalBaseURL }()
+
+	client, err := Init("test-api-key")
+	assert.NoError(t, err)
+
+	walker := client.NewShowWalker(1) // default concurrency of 1
+
+	var mu sync.Mutex
+	episodeCounts := map[int]int{}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- walker.Walk(context.Background(), func(season *TVSeasonDetails, episodes []*TVEpisodeDetails) error {
+			mu.Lock()
+			episodeCounts[season.SeasonNumber] = len(episodes)
+			mu.Unlock()
+			for _, episode := range episodes {
+				assert.NotNil(t, episode)
+			}
+			return nil
+		})
+	}()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Walk did not return before the timeout, it likely deadlocked at default concurrency")
+	}
+
+	assert.Equal(t, map[int]int{1: 2, 2: 1}, episodeCounts)
+}
+
+// TestShowWalkerWalkCancellationSkipsPartialEpisodes checks that when an
+// episode fetch fails mid-season, Walk doesn't call fn for that season
+// with a partially-populated episodes slice.
+func TestShowWalkerWalkCancellationSkipsPartialEpisodes(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/3/tv/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"seasons":[{"season_number":1}]}`)
+	})
+	mux.HandleFunc("/3/tv/1/season/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":10,"season_number":1,"episodes":[{"episode_number":1},{"episode_number":2}]}`)
+	})
+	mux.HandleFunc("/3/tv/1/season/1/episode/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"status_message":"boom","status_code":11}`)
+	})
+	mux.HandleFunc("/3/tv/1/season/1/episode/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":102,"season_number":1,"episode_number":2}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	originalBaseURL := baseURL
+	baseURL = server.URL + "/3"
+	defer func() { baseURL = originalBaseURL }()
+
+	client, err := Init("test-api-key")
+	assert.NoError(t, err)
+
+	walker := client.NewShowWalker(1).WithConcurrency(2)
+
+	var fnCalled bool
+
+	done := make(chan error, 1)
+	go func() {
+		done <- walker.Walk(context.Background(), func(season *TVSeasonDetails, episodes []*TVEpisodeDetails) error {
+			fnCalled = true
+			return nil
+		})
+	}()
+
+	select {
+	case err := <-done:
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Walk did not return before the timeout")
+	}
+
+	assert.False(t, fnCalled, "fn should not be called with a partially-populated episodes slice")
+}
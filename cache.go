@@ -0,0 +1,37 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import "time"
+
+// Cache is the interface implemented by response cache backends passed to
+// Client.SetCache. Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get looks up key, decoding a hit into v. The bool reports whether
+	// key was present (and not expired); a miss is not an error.
+	Get(key string, v interface{}) (bool, error)
+	// Set stores v under key for ttl. A ttl of zero means the entry
+	// should not expire on its own.
+	Set(key string, v interface{}, ttl time.Duration) error
+}
+
+// CacheOptions configures how Client.get uses a Cache.
+type CacheOptions struct {
+	// DefaultTTL is used for any request URL that doesn't match an entry
+	// in EndpointTTLs. A zero value disables caching for those requests.
+	DefaultTTL time.Duration
+	// EndpointTTLs overrides DefaultTTL for requests whose URL contains
+	// the given substring, e.g. tvSeasonURL for season details.
+	EndpointTTLs map[string]time.Duration
+	// NegativeTTL, when non-zero, caches "resource not found" (404)
+	// responses for that long so repeated lookups of a missing resource
+	// don't keep hitting the API.
+	NegativeTTL time.Duration
+}
+
+// cacheEntry is the envelope stored in a Cache, allowing negative entries
+// to be represented without requiring callers to inspect sentinel values.
+type cacheEntry struct {
+	NotFound bool   `json:"not_found,omitempty"`
+	Body     []byte `json:"body,omitempty"`
+}
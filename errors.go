@@ -0,0 +1,126 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Sentinel errors categorizing the outcome of a request. Use errors.Is to
+// check for them, e.g. errors.Is(err, tmdb.ErrNotFound), rather than
+// string-matching StatusMessage.
+var (
+	// ErrNotFound is returned when the requested resource doesn't exist.
+	ErrNotFound = errors.New("tmdb: resource not found")
+	// ErrInvalidAPIKey is returned when apiKey is missing or rejected.
+	ErrInvalidAPIKey = errors.New("tmdb: invalid api key")
+	// ErrRateLimited is returned when the request was throttled by TMDb.
+	ErrRateLimited = errors.New("tmdb: rate limited")
+	// ErrAuthenticationFailed is returned for session/token/permission
+	// failures that aren't simply an invalid API key.
+	ErrAuthenticationFailed = errors.New("tmdb: authentication failed")
+	// ErrServiceUnavailable is returned for transient TMDb-side failures
+	// (maintenance, backend timeouts, internal errors).
+	ErrServiceUnavailable = errors.New("tmdb: service unavailable")
+)
+
+// TMDBError is returned by Client methods for an error response whose body
+// decodes as a TMDb error; an empty or undecodable error body yields a
+// plain error instead. It carries both the raw HTTP status and TMDb's own
+// status code, and wraps the matching sentinel error above, when the
+// status maps to one, so callers can use errors.Is instead of
+// string-matching StatusMessage.
+type TMDBError struct {
+	// HTTPStatus is the HTTP status code of the response.
+	HTTPStatus int
+	// TMDBStatusCode is TMDb's documented status code (1-47), as found in
+	// the response body's status_code field.
+	TMDBStatusCode int
+	// StatusMessage is TMDb's own description of the error.
+	StatusMessage string
+	// Retryable reports whether retrying the same request later is
+	// likely to succeed (rate limiting, maintenance, transient backend
+	// failures).
+	Retryable bool
+
+	sentinel error
+}
+
+// Error implements the error interface.
+func (e *TMDBError) Error() string {
+	return e.StatusMessage
+}
+
+// Unwrap allows errors.Is(err, tmdb.ErrNotFound) and friends to match.
+func (e *TMDBError) Unwrap() error {
+	return e.sentinel
+}
+
+// tmdbStatusCodeSentinels maps TMDb's documented status codes to the
+// sentinel error category they represent. See
+// https://developers.themoviedb.org/3/getting-started/status-codes.
+var tmdbStatusCodeSentinels = map[int]error{
+	6:  ErrNotFound,
+	21: ErrNotFound,
+	34: ErrNotFound,
+	44: ErrNotFound,
+	7:  ErrInvalidAPIKey,
+	3:  ErrAuthenticationFailed,
+	14: ErrAuthenticationFailed,
+	16: ErrAuthenticationFailed,
+	17: ErrAuthenticationFailed,
+	30: ErrAuthenticationFailed,
+	33: ErrAuthenticationFailed,
+	35: ErrAuthenticationFailed,
+	36: ErrAuthenticationFailed,
+	37: ErrAuthenticationFailed,
+	38: ErrAuthenticationFailed,
+	41: ErrAuthenticationFailed,
+	25: ErrRateLimited,
+	9:  ErrServiceUnavailable,
+	11: ErrServiceUnavailable,
+	24: ErrServiceUnavailable,
+	43: ErrServiceUnavailable,
+	46: ErrServiceUnavailable,
+}
+
+// retryableSentinels are the sentinel categories worth retrying without
+// any change to the request.
+var retryableSentinels = map[error]bool{
+	ErrRateLimited:        true,
+	ErrServiceUnavailable: true,
+}
+
+// classifyTMDBError maps a decoded TMDb error body to a sentinel,
+// falling back to the HTTP status when TMDb's own status_code isn't in
+// tmdbStatusCodeSentinels. It returns nil when neither maps to a sentinel.
+func classifyTMDBError(httpStatus int, e Error) error {
+	if sentinel, ok := tmdbStatusCodeSentinels[e.StatusCode]; ok {
+		return sentinel
+	}
+	switch {
+	case httpStatus == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case httpStatus == http.StatusNotFound:
+		return ErrNotFound
+	case httpStatus == http.StatusUnauthorized || httpStatus == http.StatusForbidden:
+		return ErrAuthenticationFailed
+	case httpStatus >= http.StatusInternalServerError:
+		return ErrServiceUnavailable
+	}
+	return nil
+}
+
+// newTMDBError builds the TMDBError returned to callers from the raw
+// decoded body and the response's HTTP status.
+func newTMDBError(httpStatus int, e Error) *TMDBError {
+	sentinel := classifyTMDBError(httpStatus, e)
+	return &TMDBError{
+		HTTPStatus:     httpStatus,
+		TMDBStatusCode: e.StatusCode,
+		StatusMessage:  e.StatusMessage,
+		Retryable:      retryableSentinels[sentinel],
+		sentinel:       sentinel,
+	}
+}
@@ -0,0 +1,67 @@
+package tmdb
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyTMDBErrorByStatusCode(t *testing.T) {
+	cases := []struct {
+		name      string
+		tmdbCode  int
+		sentinel  error
+		retryable bool
+	}{
+		{"not found", 34, ErrNotFound, false},
+		{"invalid id", 6, ErrNotFound, false},
+		{"invalid api key", 7, ErrInvalidAPIKey, false},
+		{"authentication failed", 3, ErrAuthenticationFailed, false},
+		{"invalid token", 35, ErrAuthenticationFailed, false},
+		{"over request limit", 25, ErrRateLimited, true},
+		{"service offline", 9, ErrServiceUnavailable, true},
+		{"maintenance", 46, ErrServiceUnavailable, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := newTMDBError(http.StatusOK, Error{StatusCode: tc.tmdbCode, StatusMessage: tc.name})
+
+			assert.True(t, errors.Is(err, tc.sentinel))
+			assert.Equal(t, tc.retryable, err.Retryable)
+			assert.Equal(t, tc.tmdbCode, err.TMDBStatusCode)
+			assert.Equal(t, tc.name, err.Error())
+		})
+	}
+}
+
+func TestClassifyTMDBErrorFallsBackToHTTPStatus(t *testing.T) {
+	cases := []struct {
+		name       string
+		httpStatus int
+		sentinel   error
+	}{
+		{"http 404", http.StatusNotFound, ErrNotFound},
+		{"http 429", http.StatusTooManyRequests, ErrRateLimited},
+		{"http 401", http.StatusUnauthorized, ErrAuthenticationFailed},
+		{"http 503", http.StatusServiceUnavailable, ErrServiceUnavailable},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// An undocumented/zero TMDb status_code shouldn't prevent
+			// classification: the HTTP status should still be used.
+			err := newTMDBError(tc.httpStatus, Error{StatusCode: 0})
+
+			assert.True(t, errors.Is(err, tc.sentinel))
+		})
+	}
+}
+
+func TestTMDBErrorDoesNotMatchUnrelatedSentinel(t *testing.T) {
+	err := newTMDBError(http.StatusOK, Error{StatusCode: 34, StatusMessage: "not found"})
+
+	assert.False(t, errors.Is(err, ErrInvalidAPIKey))
+}
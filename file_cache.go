@@ -0,0 +1,92 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileCache is a Cache implementation that stores one JSON blob per key
+// under Dir, mirroring the file-store pattern TMDb consumers already use
+// to persist downloaded images and configuration. It suits long-running
+// services that want caching to survive a restart.
+type FileCache struct {
+	// Dir is the directory entries are stored under. It is created on
+	// first use if it doesn't already exist.
+	Dir string
+}
+
+// NewFileCache creates a FileCache rooted at dir.
+func NewFileCache(dir string) *FileCache {
+	return &FileCache{Dir: dir}
+}
+
+// fileCacheEntry is the on-disk representation of a cached value,
+// carrying its own expiry so entries remain self-describing across
+// restarts.
+type fileCacheEntry struct {
+	Body      json.RawMessage `json:"body"`
+	ExpiresAt time.Time       `json:"expires_at,omitempty"`
+}
+
+// Get implements Cache.
+func (f *FileCache) Get(key string, v interface{}) (bool, error) {
+	raw, err := ioutil.ReadFile(f.path(key))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var entry fileCacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return false, err
+	}
+	if !entry.ExpiresAt.IsZero() && time.Now().After(entry.ExpiresAt) {
+		_ = os.Remove(f.path(key))
+		return false, nil
+	}
+
+	if err := json.Unmarshal(entry.Body, v); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Set implements Cache.
+func (f *FileCache) Set(key string, v interface{}, ttl time.Duration) error {
+	if err := os.MkdirAll(f.Dir, 0o755); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	raw, err := json.Marshal(fileCacheEntry{Body: body, ExpiresAt: expiresAt})
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(f.path(key), raw, 0o644)
+}
+
+// path returns the on-disk path for key, hashing it so arbitrary cache
+// keys (which may contain query strings) always map to a valid filename.
+func (f *FileCache) path(key string) string {
+	sum := sha1.Sum([]byte(key))
+	return filepath.Join(f.Dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,57 @@
+package tmdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileCacheGetSetRoundTrip(t *testing.T) {
+	c := NewFileCache(t.TempDir())
+
+	assert.NoError(t, c.Set("key", cacheEntry{Body: []byte(`{"id":1}`)}, time.Minute))
+
+	var got cacheEntry
+	found, err := c.Get("key", &got)
+
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.JSONEq(t, `{"id":1}`, string(got.Body))
+}
+
+func TestFileCacheNegativeEntryRoundTrip(t *testing.T) {
+	c := NewFileCache(t.TempDir())
+
+	assert.NoError(t, c.Set("missing", cacheEntry{NotFound: true}, time.Minute))
+
+	var got cacheEntry
+	found, err := c.Get("missing", &got)
+
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.True(t, got.NotFound)
+}
+
+func TestFileCacheMiss(t *testing.T) {
+	c := NewFileCache(t.TempDir())
+
+	var got cacheEntry
+	found, err := c.Get("nope", &got)
+
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestFileCacheTTLExpiry(t *testing.T) {
+	c := NewFileCache(t.TempDir())
+
+	assert.NoError(t, c.Set("key", cacheEntry{Body: []byte("1")}, time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+
+	var got cacheEntry
+	found, err := c.Get("key", &got)
+
+	assert.NoError(t, err)
+	assert.False(t, found, "entry should have expired")
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import (
+	"container/list"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// MemoryCache is an in-memory, LRU-evicting Cache implementation, and the
+// Cache implementation SetCache is typically configured with. Caching is
+// disabled by default: a Client doesn't use MemoryCache (or any Cache)
+// until SetCache is called with one. It is safe for concurrent use by
+// multiple goroutines.
+type MemoryCache struct {
+	mu       sync.Mutex
+	maxItems int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+// memoryCacheItem is the value stored in the LRU list.
+type memoryCacheItem struct {
+	key       string
+	body      []byte
+	expiresAt time.Time
+}
+
+// NewMemoryCache creates a MemoryCache holding at most maxItems entries,
+// evicting the least recently used entry once that limit is reached. A
+// maxItems of zero or less means unbounded.
+func NewMemoryCache(maxItems int) *MemoryCache {
+	return &MemoryCache{
+		maxItems: maxItems,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get implements Cache.
+func (m *MemoryCache) Get(key string, v interface{}) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.items[key]
+	if !ok {
+		return false, nil
+	}
+	item := el.Value.(*memoryCacheItem)
+	if !item.expiresAt.IsZero() && time.Now().After(item.expiresAt) {
+		m.order.Remove(el)
+		delete(m.items, key)
+		return false, nil
+	}
+
+	m.order.MoveToFront(el)
+	if err := json.Unmarshal(item.body, v); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Set implements Cache.
+func (m *MemoryCache) Set(key string, v interface{}, ttl time.Duration) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	if el, ok := m.items[key]; ok {
+		el.Value.(*memoryCacheItem).body = body
+		el.Value.(*memoryCacheItem).expiresAt = expiresAt
+		m.order.MoveToFront(el)
+		return nil
+	}
+
+	el := m.order.PushFront(&memoryCacheItem{key: key, body: body, expiresAt: expiresAt})
+	m.items[key] = el
+
+	if m.maxItems > 0 && m.order.Len() > m.maxItems {
+		oldest := m.order.Back()
+		if oldest != nil {
+			m.order.Remove(oldest)
+			delete(m.items, oldest.Value.(*memoryCacheItem).key)
+		}
+	}
+
+	return nil
+}
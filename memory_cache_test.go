@@ -0,0 +1,80 @@
+package tmdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryCacheGetSetRoundTrip(t *testing.T) {
+	c := NewMemoryCache(10)
+
+	assert.NoError(t, c.Set("key", cacheEntry{Body: []byte(`{"id":1}`)}, time.Minute))
+
+	var got cacheEntry
+	found, err := c.Get("key", &got)
+
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.JSONEq(t, `{"id":1}`, string(got.Body))
+}
+
+func TestMemoryCacheNegativeEntryRoundTrip(t *testing.T) {
+	c := NewMemoryCache(10)
+
+	assert.NoError(t, c.Set("missing", cacheEntry{NotFound: true}, time.Minute))
+
+	var got cacheEntry
+	found, err := c.Get("missing", &got)
+
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.True(t, got.NotFound)
+}
+
+func TestMemoryCacheMiss(t *testing.T) {
+	c := NewMemoryCache(10)
+
+	var got cacheEntry
+	found, err := c.Get("nope", &got)
+
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestMemoryCacheTTLExpiry(t *testing.T) {
+	c := NewMemoryCache(10)
+
+	assert.NoError(t, c.Set("key", cacheEntry{Body: []byte("1")}, time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+
+	var got cacheEntry
+	found, err := c.Get("key", &got)
+
+	assert.NoError(t, err)
+	assert.False(t, found, "entry should have expired")
+}
+
+func TestMemoryCacheLRUEviction(t *testing.T) {
+	c := NewMemoryCache(2)
+
+	assert.NoError(t, c.Set("a", cacheEntry{Body: []byte("1")}, 0))
+	assert.NoError(t, c.Set("b", cacheEntry{Body: []byte("2")}, 0))
+
+	// Touch "a" so it becomes the most recently used, leaving "b" as the
+	// eviction candidate once a third key is inserted.
+	var tmp cacheEntry
+	_, _ = c.Get("a", &tmp)
+
+	assert.NoError(t, c.Set("c", cacheEntry{Body: []byte("3")}, 0))
+
+	var got cacheEntry
+	foundA, _ := c.Get("a", &got)
+	foundB, _ := c.Get("b", &got)
+	foundC, _ := c.Get("c", &got)
+
+	assert.True(t, foundA)
+	assert.False(t, foundB, "least recently used entry should have been evicted")
+	assert.True(t, foundC)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import (
+	"context"
+	"fmt"
+)
+
+// MovieDetails type is a struct for a movie details JSON response.
+type MovieDetails struct {
+	ID          int64   `json:"id"`
+	ImdbID      string  `json:"imdb_id"`
+	Title       string  `json:"title"`
+	Overview    string  `json:"overview"`
+	ReleaseDate string  `json:"release_date"`
+	Runtime     int     `json:"runtime"`
+	PosterPath  string  `json:"poster_path"`
+	VoteAverage float32 `json:"vote_average"`
+	VoteCount   int64   `json:"vote_count"`
+
+	// The following are only populated when requested via
+	// AppendToResponse.
+	Credits     *MovieCredits     `json:"credits,omitempty"`
+	Images      *MovieImages      `json:"images,omitempty"`
+	Videos      *MovieVideos      `json:"videos,omitempty"`
+	ExternalIDs *MovieExternalIDs `json:"external_ids,omitempty"`
+}
+
+// MovieExternalIDs type is a struct for a movie external ids JSON
+// response, as embedded via AppendToResponse("external_ids").
+type MovieExternalIDs struct {
+	ID          int64  `json:"id"`
+	ImdbID      string `json:"imdb_id"`
+	WikidataID  string `json:"wikidata_id"`
+	FacebookID  string `json:"facebook_id"`
+	InstagramID string `json:"instagram_id"`
+	TwitterID   string `json:"twitter_id"`
+}
+
+// MovieCredits type is a struct for a movie credits JSON response.
+type MovieCredits struct {
+	ID   int64 `json:"id"`
+	Cast []struct {
+		CharacterName string `json:"character"`
+		CreditID      string `json:"credit_id"`
+		ID            int64  `json:"id"`
+		Name          string `json:"name"`
+		Order         int    `json:"order"`
+		ProfilePath   string `json:"profile_path"`
+	} `json:"cast"`
+	Crew []struct {
+		CreditID    string `json:"credit_id"`
+		Department  string `json:"department"`
+		ID          int64  `json:"id"`
+		Job         string `json:"job"`
+		Name        string `json:"name"`
+		ProfilePath string `json:"profile_path"`
+	} `json:"crew"`
+}
+
+// MovieImages type is a struct for a movie images JSON response.
+type MovieImages struct {
+	ID      int64 `json:"id"`
+	Posters []struct {
+		AspectRatio float32 `json:"aspect_ratio"`
+		FilePath    string  `json:"file_path"`
+		Height      int     `json:"height"`
+		VoteAverage float32 `json:"vote_average"`
+		VoteCount   int64   `json:"vote_count"`
+		Width       int     `json:"width"`
+	} `json:"posters"`
+}
+
+// MovieVideos type is a struct for a movie videos JSON response.
+type MovieVideos struct {
+	ID      int64 `json:"id"`
+	Results []struct {
+		ID       string `json:"id"`
+		Key      string `json:"key"`
+		Name     string `json:"name"`
+		Site     string `json:"site"`
+		Size     int    `json:"size"`
+		Type     string `json:"type"`
+		Official bool   `json:"official"`
+	} `json:"results"`
+}
+
+// GetMovieDetailsWithContext get the primary movie details by id. Pass
+// WithAppendToResponse to embed sub-payloads such as credits, images or
+// videos in the same response.
+func (c *Client) GetMovieDetailsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*MovieDetails, error) {
+	o := buildRequestOptions(opts)
+	options := c.fmtOptions(urlOptions)
+	url := fmt.Sprintf(
+		"%s%s%d?api_key=%s%s%s",
+		baseURL, movieURL, id, c.apiKey, options, o.queryFragment(),
+	)
+	movieDetails := MovieDetails{}
+	if err := c.get(ctx, url, &movieDetails, opts...); err != nil {
+		return nil, err
+	}
+	return &movieDetails, nil
+}
+
+// GetMovieDetails calls GetMovieDetailsWithContext with
+// context.Background().
+func (c *Client) GetMovieDetails(
+	id int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*MovieDetails, error) {
+	return c.GetMovieDetailsWithContext(context.Background(), id, urlOptions, opts...)
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import (
+	"net/url"
+	"strings"
+	"time"
+)
+
+// requestOptions holds per-call settings built up by RequestOption
+// functions and applied on top of a getter's urlOptions map.
+type requestOptions struct {
+	appendToResponse []string
+	language         string
+	timeout          time.Duration
+	headers          map[string]string
+}
+
+// RequestOption configures a single getter call without mutating the
+// shared Client, e.g. WithLanguage or WithTimeout.
+type RequestOption func(*requestOptions)
+
+// WithAppendToResponse requests one or more additional sub-payloads (such
+// as "credits", "images" or "videos") to be embedded in a single
+// response, so callers don't have to issue a separate HTTP request per
+// sub-resource.
+func WithAppendToResponse(parts ...string) RequestOption {
+	return func(o *requestOptions) {
+		o.appendToResponse = append(o.appendToResponse, parts...)
+	}
+}
+
+// AppendToResponse is an alias of WithAppendToResponse kept for callers
+// that adopted it before the With* naming was settled on.
+func AppendToResponse(parts ...string) RequestOption {
+	return WithAppendToResponse(parts...)
+}
+
+// WithLanguage overrides the language for a single call without mutating
+// urlOptions, e.g. client.GetMovieDetails(id, nil, tmdb.WithLanguage("pt-BR")).
+func WithLanguage(language string) RequestOption {
+	return func(o *requestOptions) {
+		o.language = language
+	}
+}
+
+// WithTimeout overrides the Client's http.Client timeout for a single
+// call, useful for giving a slow bulk-fetch endpoint more room without
+// raising the timeout for every other request.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithHeader sets an additional request header for a single call.
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		if o.headers == nil {
+			o.headers = make(map[string]string)
+		}
+		o.headers[key] = value
+	}
+}
+
+// buildRequestOptions folds opts into a requestOptions value.
+func buildRequestOptions(opts []RequestOption) requestOptions {
+	o := requestOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// queryFragment renders the URL-affecting parts of o (append_to_response,
+// language) as a query string fragment to append to a request URL.
+func (o requestOptions) queryFragment() string {
+	frag := ""
+	if len(o.appendToResponse) > 0 {
+		frag += "&append_to_response=" + url.QueryEscape(strings.Join(o.appendToResponse, ","))
+	}
+	if o.language != "" {
+		frag += "&language=" + url.QueryEscape(o.language)
+	}
+	return frag
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import (
+	"context"
+	"fmt"
+)
+
+// PersonDetails type is a struct for a person details JSON response.
+type PersonDetails struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Biography   string `json:"biography"`
+	Birthday    string `json:"birthday"`
+	ProfilePath string `json:"profile_path"`
+
+	// The following are only populated when requested via
+	// AppendToResponse.
+	MovieCredits *PersonMovieCredits `json:"movie_credits,omitempty"`
+	TVCredits    *PersonTVCredits    `json:"tv_credits,omitempty"`
+	ExternalIDs  *PersonExternalIDs  `json:"external_ids,omitempty"`
+}
+
+// PersonExternalIDs type is a struct for a person external ids JSON
+// response, as embedded via AppendToResponse("external_ids").
+type PersonExternalIDs struct {
+	ID          int64  `json:"id"`
+	ImdbID      string `json:"imdb_id"`
+	FreebaseID  string `json:"freebase_id"`
+	FreebaseMID string `json:"freebase_mid"`
+	TvrageID    int64  `json:"tvrage_id"`
+	WikidataID  string `json:"wikidata_id"`
+	FacebookID  string `json:"facebook_id"`
+	InstagramID string `json:"instagram_id"`
+	TwitterID   string `json:"twitter_id"`
+}
+
+// PersonMovieCredits type is a struct for a person's movie credits JSON
+// response, as embedded via AppendToResponse("movie_credits").
+type PersonMovieCredits struct {
+	ID   int64 `json:"id"`
+	Cast []struct {
+		ID            int64  `json:"id"`
+		Title         string `json:"title"`
+		CharacterName string `json:"character"`
+		CreditID      string `json:"credit_id"`
+	} `json:"cast"`
+}
+
+// PersonTVCredits type is a struct for a person's TV credits JSON
+// response, as embedded via AppendToResponse("tv_credits").
+type PersonTVCredits struct {
+	ID   int64 `json:"id"`
+	Cast []struct {
+		ID            int64  `json:"id"`
+		Name          string `json:"name"`
+		CharacterName string `json:"character"`
+		CreditID      string `json:"credit_id"`
+	} `json:"cast"`
+}
+
+// GetPersonDetailsWithContext get the primary person details by id. Pass
+// WithAppendToResponse to embed sub-payloads such as movie_credits or
+// tv_credits in the same response.
+func (c *Client) GetPersonDetailsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*PersonDetails, error) {
+	o := buildRequestOptions(opts)
+	options := c.fmtOptions(urlOptions)
+	url := fmt.Sprintf(
+		"%s%s%d?api_key=%s%s%s",
+		baseURL, personURL, id, c.apiKey, options, o.queryFragment(),
+	)
+	personDetails := PersonDetails{}
+	if err := c.get(ctx, url, &personDetails, opts...); err != nil {
+		return nil, err
+	}
+	return &personDetails, nil
+}
+
+// GetPersonDetails calls GetPersonDetailsWithContext with
+// context.Background().
+func (c *Client) GetPersonDetails(
+	id int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*PersonDetails, error) {
+	return c.GetPersonDetailsWithContext(context.Background(), id, urlOptions, opts...)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// tokenBucket is a concurrency-safe token bucket used to cap the rate of
+// outgoing requests, so batch importers fanning out many goroutines don't
+// overwhelm the TMDb API.
+type tokenBucket struct {
+	mu         sync.Mutex
+	rate       float64 // tokens added per second
+	burst      float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+// newTokenBucket creates a bucket that allows requestsPerSecond sustained
+// requests per second with bursts of up to burst requests.
+func newTokenBucket(requestsPerSecond, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:       float64(requestsPerSecond),
+		burst:      float64(burst),
+		tokens:     float64(burst),
+		lastRefill: time.Now(),
+	}
+}
+
+// take reserves one token, returning how long the caller must wait before
+// it's actually allowed to proceed. Reservations are unconditional: tokens
+// is allowed to go negative so that concurrent callers arriving together
+// each reserve a distinct, increasing wait (1/rate, 2/rate, ...) instead
+// of all computing the same wait and firing together once it elapses.
+func (b *tokenBucket) take() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.lastRefill).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.lastRefill = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// backoffWithJitter returns an exponentially growing wait duration for
+// the given retry attempt (0-indexed), with up to 50% random jitter so
+// concurrent callers retrying a 429 don't all wake up at once.
+func backoffWithJitter(attempt int) time.Duration {
+	if attempt > 6 {
+		attempt = 6
+	}
+	base := defaultRetryDuration * time.Duration(int64(1)<<uint(attempt))
+	jitter := time.Duration(rand.Int63n(int64(base)/2 + 1))
+	return base + jitter
+}
@@ -0,0 +1,55 @@
+package tmdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucketAllowsBurstWithoutWaiting(t *testing.T) {
+	b := newTokenBucket(1, 3)
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, time.Duration(0), b.take())
+	}
+}
+
+func TestTokenBucketQueuesBeyondBurst(t *testing.T) {
+	b := newTokenBucket(10, 1)
+
+	assert.Equal(t, time.Duration(0), b.take())
+
+	first := b.take()
+	second := b.take()
+
+	assert.Greater(t, int64(first), int64(0))
+	assert.Greater(t, int64(second), int64(first), "concurrent waiters beyond burst must get distinct, increasing waits")
+}
+
+func TestTokenBucketRefillsOverTime(t *testing.T) {
+	b := newTokenBucket(1000, 1)
+	b.take()
+
+	time.Sleep(5 * time.Millisecond)
+
+	assert.Equal(t, time.Duration(0), b.take(), "bucket should have refilled a token after waiting past the rate interval")
+}
+
+func TestBackoffWithJitterGrowsWithAttempt(t *testing.T) {
+	first := backoffWithJitter(0)
+	later := backoffWithJitter(3)
+
+	assert.GreaterOrEqual(t, int64(first), int64(defaultRetryDuration))
+	assert.Greater(t, int64(later), int64(first))
+}
+
+func TestBackoffWithJitterCapsGrowth(t *testing.T) {
+	maxBase := defaultRetryDuration * 64 // 2^6, the clamp applied at attempt 6
+	maxPossible := maxBase + maxBase/2 + 1
+
+	for _, attempt := range []int{6, 7, 60, 1000} {
+		wait := backoffWithJitter(attempt)
+		assert.LessOrEqualf(t, int64(wait), int64(maxPossible), "attempt %d should be clamped at attempt 6's growth", attempt)
+	}
+}
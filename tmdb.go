@@ -13,12 +13,16 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 )
 
+// baseURL is the TMDb API root. It's a var rather than a const so tests
+// can point it at an httptest server instead of the real API.
+var baseURL = "https://api.themoviedb.org/3"
+
 // TMDb constants
 const (
-	baseURL           = "https://api.themoviedb.org/3"
 	permissionURL     = "https://www.themoviedb.org/authenticate/"
 	authenticationURL = "/authentication/"
 	movieURL          = "/movie/"
@@ -51,9 +55,26 @@ type Client struct {
 	autoRetry bool
 	// http.Client for custom configuration.
 	http http.Client
+	// cache is the optional response cache. Nil means caching is disabled.
+	cache Cache
+	// cacheOptions holds the behavior of the cache when set.
+	cacheOptions CacheOptions
+	// rateLimiter gates outgoing requests when SetRateLimit is used. Nil
+	// means requests are not throttled client-side.
+	rateLimiter *tokenBucket
+	// OnThrottle, when set, is called whenever a request is delayed by
+	// the rate limiter.
+	OnThrottle func()
+	// OnRetry, when set, is called before sleeping ahead of a retry
+	// following a 429 response, with the 1-indexed attempt number and
+	// the wait duration about to be applied.
+	OnRetry func(attempt int, wait time.Duration)
 }
 
-// Error type represents an error returned by the TMDB API.
+// Error type is the raw error body returned by the TMDB API. decodeError
+// wraps it into a *TMDBError before returning it to callers; Error itself
+// is only exported for backward compatibility with code unmarshalling
+// TMDb error bodies directly.
 type Error struct {
 	StatusMessage string `json:"status_message,omitempty"`
 	Success       bool   `json:"success,omitempty"`
@@ -78,6 +99,48 @@ func (c *Client) SetClientAutoRetry() {
 	c.autoRetry = true
 }
 
+// SetRateLimit installs a token-bucket rate limiter on the Client,
+// allowing requestsPerSecond sustained requests per second with bursts of
+// up to burst requests. The limiter is safe for concurrent use, so batch
+// importers fanning out many goroutines share a single gate instead of
+// each self-throttling independently.
+func (c *Client) SetRateLimit(requestsPerSecond, burst int) {
+	c.rateLimiter = newTokenBucket(requestsPerSecond, burst)
+}
+
+// SetCache installs cache as the Client's response cache, using opts to
+// control per-endpoint TTLs and negative caching. Passing a nil cache
+// disables caching again.
+func (c *Client) SetCache(cache Cache, opts CacheOptions) {
+	c.cache = cache
+	c.cacheOptions = opts
+}
+
+// cacheKey builds the cache key for a request URL, stripping the api_key
+// query parameter so the same resource always maps to the same key
+// regardless of which key fetched it.
+func cacheKey(requestURL string) string {
+	u, err := url.Parse(requestURL)
+	if err != nil {
+		return requestURL
+	}
+	q := u.Query()
+	q.Del("api_key")
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// ttlFor returns the TTL to use when storing url in the cache, falling
+// back to DefaultTTL when no endpoint-specific override is set.
+func (o CacheOptions) ttlFor(requestURL string) time.Duration {
+	for prefix, ttl := range o.EndpointTTLs {
+		if strings.Contains(requestURL, prefix) {
+			return ttl
+		}
+	}
+	return o.DefaultTTL
+}
+
 // retryDuration calculates the retry duration time.
 func retryDuration(resp *http.Response) time.Duration {
 	retryTime := resp.Header.Get("Retry-After")
@@ -91,14 +154,32 @@ func retryDuration(resp *http.Response) time.Duration {
 	return time.Duration(seconds) * time.Second
 }
 
-func (c *Client) get(url string, data interface{}) error {
+// get issues a GET request, applying any per-call RequestOptions on top
+// of the Client's defaults (timeout, extra headers).
+func (c *Client) get(ctx context.Context, url string, data interface{}, opts ...RequestOption) error {
 	if url == "" {
 		return errors.New("url field is empty")
 	}
 
+	if c.cache != nil {
+		hit, err := c.fromCache(url, data)
+		if err != nil {
+			return err
+		}
+		if hit {
+			return nil
+		}
+	}
+
+	o := buildRequestOptions(opts)
+
+	httpClient := c.http
 	// Setting default timeout to 10 seconds, if none is provided.
-	if c.http.Timeout == 0 {
-		c.http.Timeout = time.Second * 10
+	if httpClient.Timeout == 0 {
+		httpClient.Timeout = time.Second * 10
+	}
+	if o.timeout > 0 {
+		httpClient.Timeout = o.timeout
 	}
 
 	req, err := http.NewRequest(http.MethodGet, url, nil)
@@ -106,12 +187,24 @@ func (c *Client) get(url string, data interface{}) error {
 		return fmt.Errorf("could not fetch the url: %s", err)
 	}
 
-	// Setting context.
-	req = req.WithContext(context.Background())
+	req = req.WithContext(ctx)
 	req.Header.Add("content-type", "application/json;charset=utf-8")
+	for k, v := range o.headers {
+		req.Header.Set(k, v)
+	}
 
+	attempt := 0
 	for {
-		res, err := c.http.Do(req)
+		if c.rateLimiter != nil {
+			if wait := c.rateLimiter.take(); wait > 0 {
+				if c.OnThrottle != nil {
+					c.OnThrottle()
+				}
+				time.Sleep(wait)
+			}
+		}
+
+		res, err := httpClient.Do(req)
 		if err != nil {
 			return err
 		}
@@ -119,7 +212,15 @@ func (c *Client) get(url string, data interface{}) error {
 		defer res.Body.Close()
 
 		if res.StatusCode == http.StatusTooManyRequests && c.autoRetry {
-			time.Sleep(retryDuration(res))
+			wait := retryDuration(res)
+			if res.Header.Get("Retry-After") == "" {
+				wait = backoffWithJitter(attempt)
+			}
+			attempt++
+			if c.OnRetry != nil {
+				c.OnRetry(attempt, wait)
+			}
+			time.Sleep(wait)
 			continue
 		}
 
@@ -128,21 +229,74 @@ func (c *Client) get(url string, data interface{}) error {
 		}
 
 		if res.StatusCode != http.StatusOK {
-			return c.decodeError(res)
+			decodeErr := c.decodeError(res)
+			c.cacheNotFound(url, res.StatusCode)
+			return decodeErr
 		}
 
 		if err = json.NewDecoder(res.Body).Decode(data); err != nil {
 			return fmt.Errorf("could not decode the data: %s", err)
 		}
 
+		c.cacheStore(url, data)
+
 		break
 	}
 
 	return nil
 }
 
+// fromCache looks up url in the response cache, decoding a hit into data.
+// It reports whether the cache answered the request; a negative entry
+// reports true together with a not-found *TMDBError and leaves data as is.
+func (c *Client) fromCache(url string, data interface{}) (bool, error) {
+	var entry cacheEntry
+	found, err := c.cache.Get(cacheKey(url), &entry)
+	if err != nil || !found {
+		return false, err
+	}
+	if entry.NotFound {
+		return true, newTMDBError(http.StatusNotFound, Error{
+			StatusMessage: "The resource you requested could not be found.",
+			StatusCode:    34,
+		})
+	}
+	if err := json.Unmarshal(entry.Body, data); err != nil {
+		return false, fmt.Errorf("could not decode cached data: %s", err)
+	}
+	return true, nil
+}
+
+// cacheStore persists a successful response under url, honoring the
+// configured TTL. Cache errors are ignored: caching is an optimization
+// and must never fail a request that otherwise succeeded.
+func (c *Client) cacheStore(url string, data interface{}) {
+	if c.cache == nil {
+		return
+	}
+	body, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	ttl := c.cacheOptions.ttlFor(url)
+	if ttl <= 0 {
+		return
+	}
+	_ = c.cache.Set(cacheKey(url), cacheEntry{Body: body}, ttl)
+}
+
+// cacheNotFound records a 404 response as a negative cache entry when
+// NegativeTTL is configured, so repeated lookups of a missing resource
+// don't keep hitting the API.
+func (c *Client) cacheNotFound(url string, statusCode int) {
+	if c.cache == nil || statusCode != http.StatusNotFound || c.cacheOptions.NegativeTTL <= 0 {
+		return
+	}
+	_ = c.cache.Set(cacheKey(url), cacheEntry{NotFound: true}, c.cacheOptions.NegativeTTL)
+}
+
 // TODO: Improve post function.
-func (c *Client) post(url string, params []byte, data interface{}) error {
+func (c *Client) post(ctx context.Context, url string, params []byte, data interface{}) error {
 	if url == "" {
 		return errors.New("url field is empty")
 	}
@@ -153,6 +307,7 @@ func (c *Client) post(url string, params []byte, data interface{}) error {
 		return errors.New(err.Error())
 	}
 
+	req = req.WithContext(ctx)
 	req.Header.Add("content-type", "application/json;charset=utf-8")
 
 	res, err := c.http.Do(req)
@@ -202,5 +357,5 @@ func (c *Client) decodeError(r *http.Response) error {
 	if err != nil {
 		return fmt.Errorf("couldn't decode error: (%d) [%s]", len(resBody), resBody)
 	}
-	return e
+	return newTMDBError(r.StatusCode, e)
 }
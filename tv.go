@@ -0,0 +1,142 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import (
+	"context"
+	"fmt"
+)
+
+// TVDetails type is a struct for a TV show details JSON response.
+type TVDetails struct {
+	ID               int64   `json:"id"`
+	Name             string  `json:"name"`
+	OriginalName     string  `json:"original_name"`
+	Overview         string  `json:"overview"`
+	FirstAirDate     string  `json:"first_air_date"`
+	NumberOfEpisodes int     `json:"number_of_episodes"`
+	NumberOfSeasons  int     `json:"number_of_seasons"`
+	PosterPath       string  `json:"poster_path"`
+	VoteAverage      float32 `json:"vote_average"`
+	VoteCount        int64   `json:"vote_count"`
+	Seasons          []struct {
+		AirDate      string `json:"air_date"`
+		EpisodeCount int    `json:"episode_count"`
+		ID           int64  `json:"id"`
+		Name         string `json:"name"`
+		Overview     string `json:"overview"`
+		PosterPath   string `json:"poster_path"`
+		SeasonNumber int    `json:"season_number"`
+	} `json:"seasons"`
+
+	// The following are only populated when requested via
+	// AppendToResponse.
+	Credits     *TVAggregateCredits `json:"credits,omitempty"`
+	ExternalIDs *TVExternalIDs      `json:"external_ids,omitempty"`
+}
+
+// TVExternalIDs type is a struct for a TV show external ids JSON response.
+type TVExternalIDs struct {
+	ID          int64  `json:"id"`
+	ImdbID      string `json:"imdb_id"`
+	FreebaseID  string `json:"freebase_id"`
+	FreebaseMID string `json:"freebase_mid"`
+	TvdbID      int64  `json:"tvdb_id"`
+	TvrageID    int64  `json:"tvrage_id"`
+}
+
+// TVAggregateCredits type is a struct for a TV show aggregate credits JSON
+// response, where each cast/crew member is rolled up across every season
+// and episode of the show instead of listed once per episode.
+type TVAggregateCredits struct {
+	ID   int64 `json:"id"`
+	Cast []struct {
+		ID                int64  `json:"id"`
+		Name              string `json:"name"`
+		OriginalName      string `json:"original_name"`
+		ProfilePath       string `json:"profile_path"`
+		Order             int    `json:"order"`
+		TotalEpisodeCount int    `json:"total_episode_count"`
+		Roles             []struct {
+			CreditID     string `json:"credit_id"`
+			Character    string `json:"character"`
+			EpisodeCount int    `json:"episode_count"`
+		} `json:"roles"`
+	} `json:"cast"`
+	Crew []struct {
+		ID                int64  `json:"id"`
+		Name              string `json:"name"`
+		OriginalName      string `json:"original_name"`
+		ProfilePath       string `json:"profile_path"`
+		Department        string `json:"department"`
+		TotalEpisodeCount int    `json:"total_episode_count"`
+		Jobs              []struct {
+			CreditID     string `json:"credit_id"`
+			Job          string `json:"job"`
+			EpisodeCount int    `json:"episode_count"`
+		} `json:"jobs"`
+	} `json:"crew"`
+}
+
+// GetTVAggregateCreditsWithContext get the TV show aggregate credits by
+// id, rolling up cast and crew across every season and episode of the
+// show so callers don't need to sum per-episode credits themselves.
+func (c *Client) GetTVAggregateCreditsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVAggregateCredits, error) {
+	o := buildRequestOptions(opts)
+	options := c.fmtOptions(urlOptions)
+	url := fmt.Sprintf(
+		"%s%s%d%s?api_key=%s%s%s",
+		baseURL, tvURL, id, "/aggregate_credits", c.apiKey, options, o.queryFragment(),
+	)
+	tvAggregateCredits := TVAggregateCredits{}
+	if err := c.get(ctx, url, &tvAggregateCredits, opts...); err != nil {
+		return nil, err
+	}
+	return &tvAggregateCredits, nil
+}
+
+// GetTVAggregateCredits calls GetTVAggregateCreditsWithContext with
+// context.Background().
+func (c *Client) GetTVAggregateCredits(
+	id int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVAggregateCredits, error) {
+	return c.GetTVAggregateCreditsWithContext(context.Background(), id, urlOptions, opts...)
+}
+
+// GetTVDetailsWithContext get the primary TV show details by id. Pass
+// WithAppendToResponse to embed sub-payloads such as credits or
+// external_ids in the same response.
+func (c *Client) GetTVDetailsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVDetails, error) {
+	o := buildRequestOptions(opts)
+	options := c.fmtOptions(urlOptions)
+	url := fmt.Sprintf(
+		"%s%s%d?api_key=%s%s%s",
+		baseURL, tvURL, id, c.apiKey, options, o.queryFragment(),
+	)
+	tvDetails := TVDetails{}
+	if err := c.get(ctx, url, &tvDetails, opts...); err != nil {
+		return nil, err
+	}
+	return &tvDetails, nil
+}
+
+// GetTVDetails calls GetTVDetailsWithContext with context.Background().
+func (c *Client) GetTVDetails(
+	id int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVDetails, error) {
+	return c.GetTVDetailsWithContext(context.Background(), id, urlOptions, opts...)
+}
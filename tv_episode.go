@@ -0,0 +1,61 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import (
+	"context"
+	"fmt"
+)
+
+// TVEpisodeDetails type is a struct for an episode details JSON response.
+type TVEpisodeDetails struct {
+	ID             int64   `json:"id"`
+	AirDate        string  `json:"air_date"`
+	EpisodeNumber  int     `json:"episode_number"`
+	Name           string  `json:"name"`
+	Overview       string  `json:"overview"`
+	ProductionCode string  `json:"production_code"`
+	SeasonNumber   int     `json:"season_number"`
+	StillPath      string  `json:"still_path"`
+	VoteAverage    float32 `json:"vote_average"`
+	VoteCount      int64   `json:"vote_count"`
+
+	// The following are only populated when requested via
+	// AppendToResponse.
+	Credits     *TVSeasonCredits     `json:"credits,omitempty"`
+	Images      *TVSeasonImages      `json:"images,omitempty"`
+	ExternalIDs *TVSeasonExternalIDs `json:"external_ids,omitempty"`
+}
+
+// GetTVEpisodeDetailsWithContext get the TV episode details by season and
+// episode number. Pass WithAppendToResponse to embed sub-payloads such as
+// credits, images or external_ids in the same response.
+func (c *Client) GetTVEpisodeDetailsWithContext(
+	ctx context.Context,
+	id, season, episodeNumber int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVEpisodeDetails, error) {
+	o := buildRequestOptions(opts)
+	options := c.fmtOptions(urlOptions)
+	url := fmt.Sprintf(
+		"%s%s%d%s%d%s%d?api_key=%s%s%s",
+		baseURL, tvURL, id, tvSeasonURL, season, tvEpisodeURL, episodeNumber,
+		c.apiKey, options, o.queryFragment(),
+	)
+	tvEpisodeDetails := TVEpisodeDetails{}
+	if err := c.get(ctx, url, &tvEpisodeDetails, opts...); err != nil {
+		return nil, err
+	}
+	return &tvEpisodeDetails, nil
+}
+
+// GetTVEpisodeDetails calls GetTVEpisodeDetailsWithContext with
+// context.Background().
+func (c *Client) GetTVEpisodeDetails(
+	id, season, episodeNumber int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVEpisodeDetails, error) {
+	return c.GetTVEpisodeDetailsWithContext(context.Background(), id, season, episodeNumber, urlOptions, opts...)
+}
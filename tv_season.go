@@ -0,0 +1,373 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import (
+	"context"
+	"fmt"
+)
+
+// TVSeasonDetails type is a struct for a season details JSON response.
+type TVSeasonDetails struct {
+	ID       int64  `json:"id"`
+	AirDate  string `json:"air_date"`
+	Episodes []struct {
+		AirDate        string  `json:"air_date"`
+		EpisodeNumber  int     `json:"episode_number"`
+		ID             int64   `json:"id"`
+		Name           string  `json:"name"`
+		Overview       string  `json:"overview"`
+		ProductionCode string  `json:"production_code"`
+		SeasonNumber   int     `json:"season_number"`
+		ShowID         int64   `json:"show_id"`
+		StillPath      string  `json:"still_path"`
+		VoteAverage    float32 `json:"vote_average"`
+		VoteCount      int64   `json:"vote_count"`
+	} `json:"episodes"`
+	Name         string `json:"name"`
+	Overview     string `json:"overview"`
+	PosterPath   string `json:"poster_path"`
+	SeasonNumber int    `json:"season_number"`
+
+	// The following are only populated when requested via
+	// AppendToResponse, e.g. GetTVSeasonDetails(id, season, nil,
+	// AppendToResponse("credits", "images", "videos")).
+	Credits      *TVSeasonCredits       `json:"credits,omitempty"`
+	Images       *TVSeasonImages        `json:"images,omitempty"`
+	Videos       *TVSeasonVideos        `json:"videos,omitempty"`
+	ExternalIDs  *TVSeasonExternalIDs   `json:"external_ids,omitempty"`
+	Translations *TVSeasonTranslations  `json:"translations,omitempty"`
+}
+
+// TVSeasonTranslations type is a struct for a season translations JSON
+// response, as embedded via AppendToResponse("translations").
+type TVSeasonTranslations struct {
+	Translations []struct {
+		Iso3166_1   string `json:"iso_3166_1"`
+		Iso639_1    string `json:"iso_639_1"`
+		Name        string `json:"name"`
+		EnglishName string `json:"english_name"`
+		Data        struct {
+			Name     string `json:"name"`
+			Overview string `json:"overview"`
+		} `json:"data"`
+	} `json:"translations"`
+}
+
+// TVSeasonChanges type is a struct for a season changes JSON response.
+type TVSeasonChanges struct {
+	Changes []struct {
+		Key   string `json:"key"`
+		Items []struct {
+			ID            string `json:"id"`
+			Action        string `json:"action"`
+			Time          string `json:"time"`
+			Value         string `json:"value"`
+			OriginalValue string `json:"original_value"`
+		} `json:"items"`
+	} `json:"changes"`
+}
+
+// TVSeasonCredits type is a struct for a season credits JSON response.
+type TVSeasonCredits struct {
+	ID   int64 `json:"id"`
+	Cast []struct {
+		CharacterName string `json:"character"`
+		CreditID      string `json:"credit_id"`
+		ID            int64  `json:"id"`
+		Name          string `json:"name"`
+		Order         int    `json:"order"`
+		ProfilePath   string `json:"profile_path"`
+	} `json:"cast"`
+	Crew []struct {
+		CreditID    string `json:"credit_id"`
+		Department  string `json:"department"`
+		ID          int64  `json:"id"`
+		Job         string `json:"job"`
+		Name        string `json:"name"`
+		ProfilePath string `json:"profile_path"`
+	} `json:"crew"`
+}
+
+// TVSeasonExternalIDs type is a struct for a season external ids JSON response.
+type TVSeasonExternalIDs struct {
+	ID          int64  `json:"id"`
+	FreebaseID  string `json:"freebase_id"`
+	FreebaseMID string `json:"freebase_mid"`
+	TvdbID      int64  `json:"tvdb_id"`
+	TvrageID    int64  `json:"tvrage_id"`
+}
+
+// TVSeasonImages type is a struct for a season images JSON response.
+type TVSeasonImages struct {
+	ID      int64 `json:"id"`
+	Posters []struct {
+		AspectRatio float32 `json:"aspect_ratio"`
+		FilePath    string  `json:"file_path"`
+		Height      int     `json:"height"`
+		VoteAverage float32 `json:"vote_average"`
+		VoteCount   int64   `json:"vote_count"`
+		Width       int     `json:"width"`
+	} `json:"posters"`
+}
+
+// TVSeasonVideos type is a struct for a season videos JSON response.
+type TVSeasonVideos struct {
+	ID      int64 `json:"id"`
+	Results []struct {
+		ID       string `json:"id"`
+		Key      string `json:"key"`
+		Name     string `json:"name"`
+		Site     string `json:"site"`
+		Size     int    `json:"size"`
+		Type     string `json:"type"`
+		Official bool   `json:"official"`
+	} `json:"results"`
+}
+
+// TVSeasonAggregateCredits type is a struct for a season aggregate credits
+// JSON response, where each cast/crew member is rolled up across every
+// episode of the season instead of listed once per episode.
+type TVSeasonAggregateCredits struct {
+	ID   int64 `json:"id"`
+	Cast []struct {
+		ID                int64  `json:"id"`
+		Name              string `json:"name"`
+		OriginalName      string `json:"original_name"`
+		ProfilePath       string `json:"profile_path"`
+		Order             int    `json:"order"`
+		TotalEpisodeCount int    `json:"total_episode_count"`
+		Roles             []struct {
+			CreditID     string `json:"credit_id"`
+			Character    string `json:"character"`
+			EpisodeCount int    `json:"episode_count"`
+		} `json:"roles"`
+	} `json:"cast"`
+	Crew []struct {
+		ID                int64  `json:"id"`
+		Name              string `json:"name"`
+		OriginalName      string `json:"original_name"`
+		ProfilePath       string `json:"profile_path"`
+		Department        string `json:"department"`
+		TotalEpisodeCount int    `json:"total_episode_count"`
+		Jobs              []struct {
+			CreditID     string `json:"credit_id"`
+			Job          string `json:"job"`
+			EpisodeCount int    `json:"episode_count"`
+		} `json:"jobs"`
+	} `json:"crew"`
+}
+
+// GetTVSeasonAggregateCreditsWithContext get the TV season aggregate
+// credits by id, rolling up cast and crew across every episode of the
+// season so callers don't need to sum per-episode credits themselves.
+func (c *Client) GetTVSeasonAggregateCreditsWithContext(
+	ctx context.Context,
+	id, season int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVSeasonAggregateCredits, error) {
+	o := buildRequestOptions(opts)
+	options := c.fmtOptions(urlOptions)
+	url := fmt.Sprintf(
+		"%s%s%d%s%d%s?api_key=%s%s%s",
+		baseURL, tvURL, id, tvSeasonURL, season, "/aggregate_credits", c.apiKey, options, o.queryFragment(),
+	)
+	tvSeasonAggregateCredits := TVSeasonAggregateCredits{}
+	if err := c.get(ctx, url, &tvSeasonAggregateCredits, opts...); err != nil {
+		return nil, err
+	}
+	return &tvSeasonAggregateCredits, nil
+}
+
+// GetTVSeasonAggregateCredits calls GetTVSeasonAggregateCreditsWithContext
+// with context.Background().
+func (c *Client) GetTVSeasonAggregateCredits(
+	id, season int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVSeasonAggregateCredits, error) {
+	return c.GetTVSeasonAggregateCreditsWithContext(context.Background(), id, season, urlOptions, opts...)
+}
+
+// GetTVSeasonDetailsWithContext get the TV season details by id. Pass
+// WithAppendToResponse to embed sub-payloads such as credits, images or
+// videos in the same response.
+func (c *Client) GetTVSeasonDetailsWithContext(
+	ctx context.Context,
+	id, season int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVSeasonDetails, error) {
+	o := buildRequestOptions(opts)
+	options := c.fmtOptions(urlOptions)
+	url := fmt.Sprintf(
+		"%s%s%d%s%d?api_key=%s%s%s",
+		baseURL, tvURL, id, tvSeasonURL, season, c.apiKey, options, o.queryFragment(),
+	)
+	tvSeasonDetails := TVSeasonDetails{}
+	if err := c.get(ctx, url, &tvSeasonDetails, opts...); err != nil {
+		return nil, err
+	}
+	return &tvSeasonDetails, nil
+}
+
+// GetTVSeasonDetails calls GetTVSeasonDetailsWithContext with
+// context.Background().
+func (c *Client) GetTVSeasonDetails(
+	id, season int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVSeasonDetails, error) {
+	return c.GetTVSeasonDetailsWithContext(context.Background(), id, season, urlOptions, opts...)
+}
+
+// GetTVSeasonChangesWithContext get the TV season changes by id.
+func (c *Client) GetTVSeasonChangesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVSeasonChanges, error) {
+	o := buildRequestOptions(opts)
+	options := c.fmtOptions(urlOptions)
+	url := fmt.Sprintf(
+		"%s%s%s%d%s?api_key=%s%s%s",
+		baseURL, tvURL, tvSeasonURL, id, "/changes", c.apiKey, options, o.queryFragment(),
+	)
+	tvSeasonChanges := TVSeasonChanges{}
+	if err := c.get(ctx, url, &tvSeasonChanges, opts...); err != nil {
+		return nil, err
+	}
+	return &tvSeasonChanges, nil
+}
+
+// GetTVSeasonChanges calls GetTVSeasonChangesWithContext with
+// context.Background().
+func (c *Client) GetTVSeasonChanges(
+	id int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVSeasonChanges, error) {
+	return c.GetTVSeasonChangesWithContext(context.Background(), id, urlOptions, opts...)
+}
+
+// GetTVSeasonCreditsWithContext get the TV season credits by id.
+func (c *Client) GetTVSeasonCreditsWithContext(
+	ctx context.Context,
+	id, season int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVSeasonCredits, error) {
+	o := buildRequestOptions(opts)
+	options := c.fmtOptions(urlOptions)
+	url := fmt.Sprintf(
+		"%s%s%d%s%d%s?api_key=%s%s%s",
+		baseURL, tvURL, id, tvSeasonURL, season, "/credits", c.apiKey, options, o.queryFragment(),
+	)
+	tvSeasonCredits := TVSeasonCredits{}
+	if err := c.get(ctx, url, &tvSeasonCredits, opts...); err != nil {
+		return nil, err
+	}
+	return &tvSeasonCredits, nil
+}
+
+// GetTVSeasonCredits calls GetTVSeasonCreditsWithContext with
+// context.Background().
+func (c *Client) GetTVSeasonCredits(
+	id, season int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVSeasonCredits, error) {
+	return c.GetTVSeasonCreditsWithContext(context.Background(), id, season, urlOptions, opts...)
+}
+
+// GetTVSeasonExternalIDsWithContext get the TV season external ids by id.
+func (c *Client) GetTVSeasonExternalIDsWithContext(
+	ctx context.Context,
+	id, season int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVSeasonExternalIDs, error) {
+	o := buildRequestOptions(opts)
+	options := c.fmtOptions(urlOptions)
+	url := fmt.Sprintf(
+		"%s%s%d%s%d%s?api_key=%s%s%s",
+		baseURL, tvURL, id, tvSeasonURL, season, "/external_ids", c.apiKey, options, o.queryFragment(),
+	)
+	tvSeasonExternalIDs := TVSeasonExternalIDs{}
+	if err := c.get(ctx, url, &tvSeasonExternalIDs, opts...); err != nil {
+		return nil, err
+	}
+	return &tvSeasonExternalIDs, nil
+}
+
+// GetTVSeasonExternalIDs calls GetTVSeasonExternalIDsWithContext with
+// context.Background().
+func (c *Client) GetTVSeasonExternalIDs(
+	id, season int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVSeasonExternalIDs, error) {
+	return c.GetTVSeasonExternalIDsWithContext(context.Background(), id, season, urlOptions, opts...)
+}
+
+// GetTVSeasonImagesWithContext get the TV season images by id.
+func (c *Client) GetTVSeasonImagesWithContext(
+	ctx context.Context,
+	id, season int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVSeasonImages, error) {
+	o := buildRequestOptions(opts)
+	options := c.fmtOptions(urlOptions)
+	url := fmt.Sprintf(
+		"%s%s%d%s%d%s?api_key=%s%s%s",
+		baseURL, tvURL, id, tvSeasonURL, season, "/images", c.apiKey, options, o.queryFragment(),
+	)
+	tvSeasonImages := TVSeasonImages{}
+	if err := c.get(ctx, url, &tvSeasonImages, opts...); err != nil {
+		return nil, err
+	}
+	return &tvSeasonImages, nil
+}
+
+// GetTVSeasonImages calls GetTVSeasonImagesWithContext with
+// context.Background().
+func (c *Client) GetTVSeasonImages(
+	id, season int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVSeasonImages, error) {
+	return c.GetTVSeasonImagesWithContext(context.Background(), id, season, urlOptions, opts...)
+}
+
+// GetTVSeasonVideosWithContext get the TV season videos by id.
+func (c *Client) GetTVSeasonVideosWithContext(
+	ctx context.Context,
+	id, season int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVSeasonVideos, error) {
+	o := buildRequestOptions(opts)
+	options := c.fmtOptions(urlOptions)
+	url := fmt.Sprintf(
+		"%s%s%d%s%d%s?api_key=%s%s%s",
+		baseURL, tvURL, id, tvSeasonURL, season, "/videos", c.apiKey, options, o.queryFragment(),
+	)
+	tvSeasonVideos := TVSeasonVideos{}
+	if err := c.get(ctx, url, &tvSeasonVideos, opts...); err != nil {
+		return nil, err
+	}
+	return &tvSeasonVideos, nil
+}
+
+// GetTVSeasonVideos calls GetTVSeasonVideosWithContext with
+// context.Background().
+func (c *Client) GetTVSeasonVideos(
+	id, season int,
+	urlOptions map[string]string,
+	opts ...RequestOption,
+) (*TVSeasonVideos, error) {
+	return c.GetTVSeasonVideosWithContext(context.Background(), id, season, urlOptions, opts...)
+}